@@ -0,0 +1,24 @@
+package authz
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrForbidden is returned when a policy check denies an operation.
+var ErrForbidden = errors.New("authz: forbidden")
+
+// Authorizer decides whether the caller in ctx may perform action on
+// resource.
+type Authorizer interface {
+	Authorize(ctx context.Context, action, resource string) error
+}
+
+// NoopAuthorizer allows every call. It exists for tests and for
+// deployments that have not opted into policy enforcement yet.
+type NoopAuthorizer struct{}
+
+// Authorize implements Authorizer.
+func (NoopAuthorizer) Authorize(context.Context, string, string) error {
+	return nil
+}
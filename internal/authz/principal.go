@@ -0,0 +1,24 @@
+package authz
+
+import "context"
+
+// Principal identifies the caller on whose behalf an operation is
+// performed.
+type Principal struct {
+	Subject string
+	Roles   []string
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying p, retrievable via
+// PrincipalFromContext.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the Principal stored in ctx, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,71 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// DefaultQuery is the Rego query evaluated by RegoAuthorizer unless a
+// caller overrides it.
+const DefaultQuery = "allow = data.aqe.users.allow"
+
+// RegoAuthorizer enforces access decisions using a policy evaluated by
+// an embedded OPA engine. Policies are loaded from .rego files on disk,
+// so they can be updated without recompiling the binary.
+//
+// This pulls in the full github.com/open-policy-agent/opa/rego
+// evaluator and its dependency graph (gRPC, OpenTelemetry, the
+// Prometheus client, etc.) rather than a narrower Rego-only library.
+// We accept that weight because operators already run OPA elsewhere in
+// this fleet, so policies, tooling, and the `allow = data...` query
+// style used here stay consistent with that; a lighter embedded
+// evaluator would save binary size but fragment the policy story.
+type RegoAuthorizer struct {
+	query rego.PreparedEvalQuery
+}
+
+// NewRegoAuthorizer compiles the .rego files under policyPath into a
+// prepared query. query is typically DefaultQuery.
+func NewRegoAuthorizer(ctx context.Context, policyPath, query string) (*RegoAuthorizer, error) {
+	prepared, err := rego.New(
+		rego.Query(query),
+		rego.Load([]string{policyPath}, nil),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authz: compile policy at %q: %w", policyPath, err)
+	}
+	return &RegoAuthorizer{query: prepared}, nil
+}
+
+// Authorize implements Authorizer by evaluating the prepared query
+// against an input document of {user, action, resource}.
+func (a *RegoAuthorizer) Authorize(ctx context.Context, action, resource string) error {
+	principal, _ := PrincipalFromContext(ctx)
+
+	input := map[string]any{
+		"user":     principal.Subject,
+		"action":   action,
+		"resource": resource,
+	}
+
+	results, err := a.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return fmt.Errorf("authz: evaluate policy: %w", err)
+	}
+	if !decisionAllows(results) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// decisionAllows interprets the query result binding named "allow" in
+// DefaultQuery and any custom query shaped the same way.
+func decisionAllows(results rego.ResultSet) bool {
+	if len(results) == 0 || len(results[0].Bindings) == 0 {
+		return false
+	}
+	allow, ok := results[0].Bindings["allow"].(bool)
+	return ok && allow
+}
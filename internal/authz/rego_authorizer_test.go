@@ -0,0 +1,53 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ownerOnlyPolicy allows a principal to act only on the resource that
+// matches their own subject.
+const ownerOnlyPolicy = `package aqe.users
+
+default allow = false
+
+allow {
+	input.user == input.resource
+}
+`
+
+func newTestRegoAuthorizer(t *testing.T) *RegoAuthorizer {
+	t.Helper()
+
+	policyPath := filepath.Join(t.TempDir(), "users.rego")
+	if err := os.WriteFile(policyPath, []byte(ownerOnlyPolicy), 0o644); err != nil {
+		t.Fatalf("write policy: %v", err)
+	}
+
+	authorizer, err := NewRegoAuthorizer(context.Background(), policyPath, DefaultQuery)
+	if err != nil {
+		t.Fatalf("NewRegoAuthorizer: %v", err)
+	}
+	return authorizer
+}
+
+func TestRegoAuthorizer_Allow(t *testing.T) {
+	authorizer := newTestRegoAuthorizer(t)
+	ctx := WithPrincipal(context.Background(), Principal{Subject: "1"})
+
+	if err := authorizer.Authorize(ctx, "get", "1"); err != nil {
+		t.Fatalf("Authorize() error = %v, want nil", err)
+	}
+}
+
+func TestRegoAuthorizer_Deny(t *testing.T) {
+	authorizer := newTestRegoAuthorizer(t)
+	ctx := WithPrincipal(context.Background(), Principal{Subject: "1"})
+
+	if err := authorizer.Authorize(ctx, "get", "2"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("Authorize() error = %v, want ErrForbidden", err)
+	}
+}
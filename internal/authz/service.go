@@ -0,0 +1,36 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+// Service wraps a *user.Service with a policy check evaluated by an
+// Authorizer before each operation reaches the underlying store.
+type Service struct {
+	next  auth
+	authz Authorizer
+}
+
+// auth is the subset of *user.Service that Service wraps. It exists so
+// Service can be tested against a fake without touching user.Service.
+type auth interface {
+	GetUser(ctx context.Context, id string) (*user.User, error)
+}
+
+// NewService returns a Service that authorizes calls to next using authz
+// before delegating to it.
+func NewService(next auth, authz Authorizer) *Service {
+	return &Service{next: next, authz: authz}
+}
+
+// GetUser authorizes the "get" action on the requested user before
+// delegating to the wrapped service. A denied check returns ErrForbidden
+// without touching the underlying store.
+func (s *Service) GetUser(ctx context.Context, id string) (*user.User, error) {
+	if err := s.authz.Authorize(ctx, "get", id); err != nil {
+		return nil, err
+	}
+	return s.next.GetUser(ctx, id)
+}
@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+type fakeAuth struct {
+	called bool
+	user   *user.User
+	err    error
+}
+
+func (f *fakeAuth) GetUser(context.Context, string) (*user.User, error) {
+	f.called = true
+	return f.user, f.err
+}
+
+type denyAuthorizer struct{}
+
+func (denyAuthorizer) Authorize(context.Context, string, string) error {
+	return ErrForbidden
+}
+
+func TestService_GetUser_Allowed(t *testing.T) {
+	next := &fakeAuth{user: &user.User{ID: "1", Name: "Ada"}}
+	svc := NewService(next, NoopAuthorizer{})
+
+	got, err := svc.GetUser(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetUser() error = %v, want nil", err)
+	}
+	if got.ID != "1" || !next.called {
+		t.Fatalf("GetUser() = %v, did not delegate to next", got)
+	}
+}
+
+func TestService_GetUser_Denied(t *testing.T) {
+	next := &fakeAuth{user: &user.User{ID: "1", Name: "Ada"}}
+	svc := NewService(next, denyAuthorizer{})
+
+	_, err := svc.GetUser(context.Background(), "1")
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("GetUser() error = %v, want ErrForbidden", err)
+	}
+	if next.called {
+		t.Fatal("GetUser() reached the underlying store after a denied check")
+	}
+}
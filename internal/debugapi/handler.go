@@ -0,0 +1,86 @@
+// Package debugapi mounts a pprof-integrated debug surface for
+// UserService under /debug/aqe/.
+package debugapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	nethttppprof "net/http/pprof"
+	"runtime/pprof"
+	"strings"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+const basePath = "/debug/aqe/"
+
+// Handler serves:
+//
+//	GET /debug/aqe/           - index, with links to every profile below
+//	GET /debug/aqe/users      - live user count
+//	GET /debug/aqe/latency    - GetUser latency histogram
+//	GET /debug/aqe/profile    - 30s CPU profile (net/http/pprof.Profile)
+//	GET /debug/aqe/profile/{name} - any profile known to runtime/pprof
+type Handler struct {
+	users *user.Service
+	mux   *http.ServeMux
+}
+
+// New returns a debug Handler for users.
+func New(users *user.Service) *Handler {
+	h := &Handler{users: users, mux: http.NewServeMux()}
+	h.mux.HandleFunc(basePath, h.index)
+	h.mux.HandleFunc(basePath+"users", h.handleUsers)
+	h.mux.HandleFunc(basePath+"latency", h.handleLatency)
+	h.mux.HandleFunc(basePath+"profile", nethttppprof.Profile)
+
+	// Looking the profile up by name at request time, rather than
+	// hardcoding "heap"/"allocs"/"goroutine", means a profile
+	// registered later (e.g. pprof.NewProfile("user-cache")) is served
+	// and listed automatically, even one registered after New returns.
+	h.mux.HandleFunc(basePath+"profile/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, basePath+"profile/")
+		nethttppprof.Handler(name).ServeHTTP(w, r)
+	})
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) index(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != basePath {
+		http.NotFound(w, r)
+		return
+	}
+
+	fmt.Fprintf(w, "<html><body>\n")
+	fmt.Fprintf(w, "<a href=\"%[1]susers\">%[1]susers</a><br/>\n", basePath)
+	fmt.Fprintf(w, "<a href=\"%[1]slatency\">%[1]slatency</a><br/>\n", basePath)
+	fmt.Fprintf(w, "<a href=\"%[1]sprofile?seconds=30\">%[1]sprofile</a> (30s CPU profile)<br/>\n", basePath)
+	for _, p := range pprof.Profiles() {
+		fmt.Fprintf(w, "<a href=\"%[1]sprofile/%[2]s?debug=1\">%[1]sprofile/%[2]s</a><br/>\n", basePath, p.Name())
+	}
+	fmt.Fprintf(w, "</body></html>\n")
+}
+
+func (h *Handler) handleUsers(w http.ResponseWriter, r *http.Request) {
+	n, err := h.users.Len(r.Context())
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, map[string]int{"count": n})
+}
+
+func (h *Handler) handleLatency(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, h.users.Stats())
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,64 @@
+package debugapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+func TestHandler_Users(t *testing.T) {
+	repo := user.NewMemoryRepository()
+	if err := repo.Save(context.Background(), &user.User{ID: "1", Name: "Ada"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	h := New(user.NewService(repo))
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/aqe/users", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /debug/aqe/users status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var got map[string]int
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got["count"] != 1 {
+		t.Fatalf("count = %d, want 1", got["count"])
+	}
+}
+
+func TestHandler_Latency(t *testing.T) {
+	repo := user.NewMemoryRepository()
+	svc := user.NewService(repo)
+	if _, err := svc.GetUser(context.Background(), "missing"); err == nil {
+		t.Fatal("GetUser(missing) error = nil, want ErrUserNotFound")
+	}
+
+	h := New(svc)
+	req := httptest.NewRequest(http.MethodGet, "/debug/aqe/latency", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /debug/aqe/latency status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var got user.LatencySnapshot
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	var total int64
+	for _, c := range got {
+		total += c
+	}
+	if total != 1 {
+		t.Fatalf("latency snapshot total = %d, want 1", total)
+	}
+}
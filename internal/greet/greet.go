@@ -0,0 +1,8 @@
+// Package greet holds the small greeting usecase shared by the HTTP API
+// and the scenario test harness.
+package greet
+
+// Greet returns the greeting shown to name.
+func Greet(name string) string {
+	return "Hello, " + name + "!"
+}
@@ -0,0 +1,128 @@
+// Package httpapi exposes UserService and Greet over HTTP.
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/greet"
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+// Handler serves:
+//
+//	GET  /users/{id}  - look up a user
+//	POST /users       - create a user
+//	GET  /greet?name= - greet a name
+type Handler struct {
+	mux   *http.ServeMux
+	users *user.Service
+	repo  user.Repository
+}
+
+// NewHandler returns an http.Handler for UserService, wrapped in
+// CustomRecovery(DefaultRecovery). Use New directly to supply a
+// different RecoveryFunc.
+func NewHandler(users *user.Service, repo user.Repository) http.Handler {
+	return CustomRecovery(DefaultRecovery)(New(users, repo))
+}
+
+// New returns the bare Handler, with no recovery middleware applied.
+func New(users *user.Service, repo user.Repository) *Handler {
+	h := &Handler{users: users, repo: repo, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/users/", h.handleGetUser)
+	h.mux.HandleFunc("/users", h.handleCreateUser)
+	h.mux.HandleFunc("/greet", h.handleGreet)
+	h.mux.HandleFunc("/hello", h.ForwardTo("/greet"))
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// ForwardTo returns a handler that rewrites r.URL.Path to path and
+// re-dispatches it through the router, enabling alias routes such as
+// "/hello" -> "/greet".
+func (h *Handler) ForwardTo(path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = path
+		h.mux.ServeHTTP(w, r)
+	}
+}
+
+func (h *Handler) handleGetUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/users/")
+	if id == "" {
+		http.Error(w, "missing user id", http.StatusBadRequest)
+		return
+	}
+
+	u, err := h.users.GetUser(r.Context(), id)
+	if errors.Is(err, user.ErrUserNotFound) {
+		http.Error(w, "user not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+type createUserRequest struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (h *Handler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req createUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ID == "" {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	u := &user.User{ID: req.ID, Name: req.Name}
+	if err := h.repo.Save(r.Context(), u); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, u)
+}
+
+func (h *Handler) handleGreet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		http.Error(w, "missing name", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(greet.Greet(name)))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,103 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+func newTestHandler() http.Handler {
+	repo := user.NewMemoryRepository()
+	return NewHandler(user.NewService(repo), repo)
+}
+
+func TestHandler_CreateThenGetUser(t *testing.T) {
+	h := newTestHandler()
+
+	createReq := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"id":"1","name":"Ada"}`))
+	createRec := httptest.NewRecorder()
+	h.ServeHTTP(createRec, createReq)
+	if createRec.Code != http.StatusCreated {
+		t.Fatalf("POST /users status = %d, want %d", createRec.Code, http.StatusCreated)
+	}
+
+	getReq := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	getRec := httptest.NewRecorder()
+	h.ServeHTTP(getRec, getReq)
+	if getRec.Code != http.StatusOK {
+		t.Fatalf("GET /users/1 status = %d, want %d", getRec.Code, http.StatusOK)
+	}
+
+	var got user.User
+	if err := json.NewDecoder(getRec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Name != "Ada" {
+		t.Fatalf("GET /users/1 = %+v, want Name=Ada", got)
+	}
+}
+
+func TestHandler_GetUser_NotFound(t *testing.T) {
+	h := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/users/missing", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("GET /users/missing status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandler_Greet(t *testing.T) {
+	h := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/greet?name=Ada", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "Hello, Ada!" {
+		t.Fatalf("GET /greet?name=Ada = (%d, %q), want (200, %q)", rec.Code, rec.Body.String(), "Hello, Ada!")
+	}
+}
+
+func TestHandler_HelloForwardsToGreet(t *testing.T) {
+	h := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/hello?name=Ada", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "Hello, Ada!" {
+		t.Fatalf("GET /hello?name=Ada = (%d, %q), want (200, %q)", rec.Code, rec.Body.String(), "Hello, Ada!")
+	}
+}
+
+func TestCustomRecovery(t *testing.T) {
+	panicky := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+
+	var recoveredWith any
+	recovery := func(w http.ResponseWriter, r *http.Request, recovered any) {
+		recoveredWith = recovered
+		w.WriteHeader(http.StatusBadGateway)
+	}
+
+	h := CustomRecovery(recovery)(panicky)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadGateway)
+	}
+	if recoveredWith != "boom" {
+		t.Fatalf("recovered = %v, want %q", recoveredWith, "boom")
+	}
+}
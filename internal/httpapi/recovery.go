@@ -0,0 +1,38 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// RecoveryFunc handles a panic recovered from a downstream handler. It
+// is responsible for writing a response to w.
+type RecoveryFunc func(w http.ResponseWriter, r *http.Request, recovered any)
+
+// CustomRecovery returns middleware that recovers panics from the
+// wrapped handler and passes them to recovery, instead of letting
+// net/http's default recovery close the connection. Callers can supply
+// their own RecoveryFunc to, e.g., translate a specific panic type into
+// a 4xx or report it to an error tracker.
+func CustomRecovery(recovery RecoveryFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					recovery(w, r, rec)
+				}
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// DefaultRecovery logs the panic and emits a generic JSON 500.
+func DefaultRecovery(w http.ResponseWriter, r *http.Request, recovered any) {
+	log.Printf("httpapi: panic handling %s %s: %v", r.Method, r.URL.Path, recovered)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(map[string]string{"error": "internal server error"})
+}
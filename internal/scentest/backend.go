@@ -0,0 +1,147 @@
+package scentest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/greet"
+	"github.com/proffesor-for-testing/agentic-qe/internal/httpapi"
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+// backend is the seam Env drives its operations through. Each Mode
+// supplies one, so a scenario written against Env is transport-agnostic.
+type backend interface {
+	createUser(ctx context.Context, id, name string) error
+	lookupUser(ctx context.Context, id string) (*user.User, error)
+	greet(ctx context.Context, name string) (string, error)
+}
+
+// newBackend returns the backend for mode, or an error if mode has no
+// implementation yet.
+func newBackend(mode Mode) (backend, error) {
+	switch mode {
+	case ModeInProcess, "":
+		return newInProcessBackend(), nil
+	case ModeHTTP:
+		return newHTTPBackend(), nil
+	case ModeGRPC:
+		return nil, fmt.Errorf("scentest: mode %q is not implemented yet", mode)
+	default:
+		return nil, fmt.Errorf("scentest: unknown mode %q", mode)
+	}
+}
+
+// inProcessBackend calls the usecase types directly.
+type inProcessBackend struct {
+	users *user.Service
+	repo  user.Repository
+}
+
+func newInProcessBackend() *inProcessBackend {
+	repo := user.NewMemoryRepository()
+	return &inProcessBackend{
+		users: user.NewService(repo),
+		repo:  repo,
+	}
+}
+
+func (b *inProcessBackend) createUser(ctx context.Context, id, name string) error {
+	return b.repo.Save(ctx, &user.User{ID: id, Name: name})
+}
+
+func (b *inProcessBackend) lookupUser(ctx context.Context, id string) (*user.User, error) {
+	return b.users.GetUser(ctx, id)
+}
+
+func (b *inProcessBackend) greet(_ context.Context, name string) (string, error) {
+	return greet.Greet(name), nil
+}
+
+// httpBackend drives the scenario over httpapi.Handler, exercised
+// through a real loopback listener via httptest.Server.
+type httpBackend struct {
+	srv    *httptest.Server
+	client *http.Client
+}
+
+func newHTTPBackend() *httpBackend {
+	repo := user.NewMemoryRepository()
+	handler := httpapi.NewHandler(user.NewService(repo), repo)
+	return &httpBackend{srv: httptest.NewServer(handler), client: http.DefaultClient}
+}
+
+// close shuts down the underlying server. Run calls it once the
+// scenario finishes.
+func (b *httpBackend) close() {
+	b.srv.Close()
+}
+
+func (b *httpBackend) createUser(ctx context.Context, id, name string) error {
+	body, _ := json.Marshal(map[string]string{"id": id, "name": name})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.srv.URL+"/users", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("scentest: POST /users: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (b *httpBackend) lookupUser(ctx context.Context, id string) (*user.User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.srv.URL+"/users/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, user.ErrUserNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scentest: GET /users/%s: unexpected status %d", id, resp.StatusCode)
+	}
+
+	var u user.User
+	if err := json.NewDecoder(resp.Body).Decode(&u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (b *httpBackend) greet(ctx context.Context, name string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.srv.URL+"/greet?name="+url.QueryEscape(name), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("scentest: GET /greet: unexpected status %d", resp.StatusCode)
+	}
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(got), nil
+}
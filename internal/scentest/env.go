@@ -0,0 +1,103 @@
+package scentest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+// Env is the fluent surface a scenario drives. Its operations are
+// backed by whichever Mode the scenario is running under.
+type Env struct {
+	t       testing.TB
+	ctx     context.Context
+	backend backend
+}
+
+// CreateUser creates a user with the given id and name, failing the
+// test immediately on error.
+func (e *Env) CreateUser(id, name string) {
+	e.t.Helper()
+	if err := e.backend.createUser(e.ctx, id, name); err != nil {
+		e.t.Fatalf("scentest: CreateUser(%q, %q): %v", id, name, err)
+	}
+}
+
+// LookupUser returns the user with the given id, or the error the
+// backend reported (e.g. user.ErrUserNotFound).
+func (e *Env) LookupUser(id string) (*user.User, error) {
+	return e.backend.lookupUser(e.ctx, id)
+}
+
+// Greet returns the greeting for name, failing the test immediately on
+// error.
+func (e *Env) Greet(name string) string {
+	e.t.Helper()
+	got, err := e.backend.greet(e.ctx, name)
+	if err != nil {
+		e.t.Fatalf("scentest: Greet(%q): %v", name, err)
+	}
+	return got
+}
+
+// Matcher reports whether the condition it checks holds in e, so it can
+// be polled by Await.
+type Matcher func(e *Env) (bool, error)
+
+// Await blocks until m holds against e, or fails the test once timeout
+// elapses.
+func (e *Env) Await(m Matcher) {
+	e.t.Helper()
+
+	const timeout = 2 * time.Second
+	const interval = 10 * time.Millisecond
+
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, err := m(e)
+		if err != nil {
+			e.t.Fatalf("scentest: Await: %v", err)
+		}
+		if ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			e.t.Fatalf("scentest: Await: condition not met within %s", timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// UserExists is a Matcher that holds once id can be looked up without
+// error.
+func UserExists(id string) Matcher {
+	return func(e *Env) (bool, error) {
+		_, err := e.LookupUser(id)
+		if err == nil {
+			return true, nil
+		}
+		if err == user.ErrUserNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+}
+
+// Run executes scenario's body against a fresh Env, under the Mode
+// selected by ModeFromEnv.
+func Run(t *testing.T, scenario string, f func(env *Env)) {
+	t.Helper()
+	t.Run(scenario, func(t *testing.T) {
+		mode := ModeFromEnv()
+		b, err := newBackend(mode)
+		if err != nil {
+			t.Fatalf("scentest: %v", err)
+		}
+		if c, ok := b.(interface{ close() }); ok {
+			t.Cleanup(c.close)
+		}
+		f(&Env{t: t, ctx: context.Background(), backend: b})
+	})
+}
@@ -0,0 +1,34 @@
+package scentest
+
+import "os"
+
+// Mode selects which transport a scenario drives UserService and Greet
+// through. The same scenario body runs unmodified under every mode.
+type Mode string
+
+const (
+	// ModeInProcess calls the usecase types directly, with no transport
+	// in between. This is the default and the fastest mode.
+	ModeInProcess Mode = "inprocess"
+
+	// ModeHTTP drives the scenario through the httpapi.Handler.
+	ModeHTTP Mode = "http"
+
+	// ModeGRPC drives the scenario through a gRPC handler. No such
+	// handler exists yet; selecting this mode fails fast.
+	ModeGRPC Mode = "grpc"
+)
+
+// modeEnvVar is read by ModeFromEnv to pick the default Mode for a test
+// binary, so the same suite can be re-run across transports via e.g.
+// `AQE_SCENTEST_MODE=http go test ./...`.
+const modeEnvVar = "AQE_SCENTEST_MODE"
+
+// ModeFromEnv returns the Mode named by modeEnvVar, or ModeInProcess if
+// it is unset.
+func ModeFromEnv() Mode {
+	if m := os.Getenv(modeEnvVar); m != "" {
+		return Mode(m)
+	}
+	return ModeInProcess
+}
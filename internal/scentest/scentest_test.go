@@ -0,0 +1,45 @@
+package scentest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/proffesor-for-testing/agentic-qe/internal/user"
+)
+
+func TestUserLookup(t *testing.T) {
+	Run(t, "create then lookup the same user", func(env *Env) {
+		env.CreateUser("1", "Ada")
+		env.Await(UserExists("1"))
+
+		got, err := env.LookupUser("1")
+		if err != nil {
+			t.Fatalf("LookupUser(1) error = %v, want nil", err)
+		}
+		if got.Name != "Ada" {
+			t.Fatalf("LookupUser(1).Name = %q, want %q", got.Name, "Ada")
+		}
+	})
+}
+
+func TestUserLookup_MissingUserIsNotNilNil(t *testing.T) {
+	Run(t, "create X then lookup Y", func(env *Env) {
+		env.CreateUser("x", "Ada")
+
+		got, err := env.LookupUser("y")
+		if !errors.Is(err, user.ErrUserNotFound) {
+			t.Fatalf("LookupUser(y) error = %v, want user.ErrUserNotFound", err)
+		}
+		if got != nil {
+			t.Fatalf("LookupUser(y) = %v, want nil", got)
+		}
+	})
+}
+
+func TestGreet(t *testing.T) {
+	Run(t, "greet a name", func(env *Env) {
+		if got, want := env.Greet("Ada"), "Hello, Ada!"; got != want {
+			t.Fatalf("Greet(Ada) = %q, want %q", got, want)
+		}
+	})
+}
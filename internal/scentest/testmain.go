@@ -0,0 +1,41 @@
+package scentest
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// Options configures the TestMain bootstrap for a package using
+// scentest.
+type Options struct {
+	// Modes restricts which Mode values the suite accepts from
+	// AQE_SCENTEST_MODE. Leave nil to accept any mode newBackend
+	// supports.
+	Modes []Mode
+}
+
+// TestMain validates the environment once per test binary and then runs
+// m. Packages that use scentest should call it from their own
+// TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(scentest.TestMain(m, scentest.Options{}))
+//	}
+func TestMain(m *testing.M, opts Options) int {
+	mode := ModeFromEnv()
+	if len(opts.Modes) > 0 && !containsMode(opts.Modes, mode) {
+		fmt.Fprintf(os.Stderr, "scentest: mode %q is not supported by this suite (want one of %v)\n", mode, opts.Modes)
+		return 1
+	}
+	return m.Run()
+}
+
+func containsMode(modes []Mode, mode Mode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryRepository is an in-memory Repository implementation, primarily
+// useful for tests and local development.
+type MemoryRepository struct {
+	mu    sync.RWMutex
+	users map[string]User
+}
+
+// NewMemoryRepository returns an empty MemoryRepository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{users: make(map[string]User)}
+}
+
+// FindByID implements Repository.
+func (r *MemoryRepository) FindByID(_ context.Context, id string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return &u, nil
+}
+
+// Save implements Repository.
+func (r *MemoryRepository) Save(_ context.Context, u *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[u.ID] = *u
+	return nil
+}
+
+// Delete implements Repository.
+func (r *MemoryRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return ErrUserNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// FindByIDs looks up every id in a single critical section instead of
+// the lock-per-id cost of repeated FindByID calls.
+func (r *MemoryRepository) FindByIDs(_ context.Context, ids []string) (map[string]*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make(map[string]*User, len(ids))
+	for _, id := range ids {
+		if u, ok := r.users[id]; ok {
+			u := u
+			out[id] = &u
+		}
+	}
+	return out, nil
+}
+
+// List implements Repository.
+func (r *MemoryRepository) List(_ context.Context) ([]*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*User, 0, len(r.users))
+	for _, u := range r.users {
+		u := u
+		out = append(out, &u)
+	}
+	return out, nil
+}
@@ -0,0 +1,12 @@
+package user
+
+import "context"
+
+// Repository abstracts persistence for User, so the usecase layer can
+// remain agnostic of the storage backend in use.
+type Repository interface {
+	FindByID(ctx context.Context, id string) (*User, error)
+	Save(ctx context.Context, u *User) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*User, error)
+}
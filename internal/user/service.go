@@ -0,0 +1,81 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Service is the UserService usecase. It depends only on the Repository
+// interface, so callers can swap storage backends without any change
+// here.
+type Service struct {
+	repo  Repository
+	stats Stats
+}
+
+// NewService returns a Service backed by repo.
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// GetUser returns the user with the given id, or ErrUserNotFound if none
+// exists.
+func (s *Service) GetUser(ctx context.Context, id string) (*User, error) {
+	start := time.Now()
+	u, err := s.repo.FindByID(ctx, id)
+	s.stats.observe(time.Since(start))
+	return u, err
+}
+
+// bulkRepository is implemented by Repository backends that can look up
+// multiple ids in one round trip. GetUserBulk uses it when available
+// instead of issuing one FindByID per id.
+type bulkRepository interface {
+	FindByIDs(ctx context.Context, ids []string) (map[string]*User, error)
+}
+
+// GetUserBulk returns the users among ids that exist, skipping ids that
+// do not. It uses the repository's bulk lookup when available.
+func (s *Service) GetUserBulk(ctx context.Context, ids []string) ([]*User, error) {
+	if bulk, ok := s.repo.(bulkRepository); ok {
+		found, err := bulk.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]*User, 0, len(ids))
+		for _, id := range ids {
+			if u, ok := found[id]; ok {
+				out = append(out, u)
+			}
+		}
+		return out, nil
+	}
+
+	out := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		u, err := s.repo.FindByID(ctx, id)
+		if errors.Is(err, ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	return out, nil
+}
+
+// Len returns the number of users currently stored.
+func (s *Service) Len(ctx context.Context) (int, error) {
+	all, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(all), nil
+}
+
+// Stats returns a snapshot of GetUser latencies observed so far.
+func (s *Service) Stats() LatencySnapshot {
+	return s.stats.Snapshot()
+}
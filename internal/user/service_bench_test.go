@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func seededService(b *testing.B, n int) (*Service, []string) {
+	b.Helper()
+
+	repo := NewMemoryRepository()
+	ids := make([]string, n)
+	ctx := context.Background()
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("user-%d", i)
+		ids[i] = id
+		if err := repo.Save(ctx, &User{ID: id, Name: id}); err != nil {
+			b.Fatalf("seed Save: %v", err)
+		}
+	}
+	return NewService(repo), ids
+}
+
+func BenchmarkGetUser(b *testing.B) {
+	const n = 10_000
+	svc, ids := seededService(b, n)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := svc.GetUser(ctx, ids[i%n]); err != nil {
+			b.Fatalf("GetUser: %v", err)
+		}
+	}
+}
+
+func BenchmarkGetUserBulk(b *testing.B) {
+	const n = 10_000
+	svc, ids := seededService(b, n)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := svc.GetUserBulk(ctx, ids); err != nil {
+			b.Fatalf("GetUserBulk: %v", err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// mockRepository is a Repository test double whose behavior is
+// configured per-field by the caller.
+type mockRepository struct {
+	findByIDFunc func(ctx context.Context, id string) (*User, error)
+}
+
+func (m *mockRepository) FindByID(ctx context.Context, id string) (*User, error) {
+	return m.findByIDFunc(ctx, id)
+}
+
+func (m *mockRepository) Save(context.Context, *User) error    { return nil }
+func (m *mockRepository) Delete(context.Context, string) error { return nil }
+func (m *mockRepository) List(context.Context) ([]*User, error) {
+	return nil, nil
+}
+
+func TestService_GetUser(t *testing.T) {
+	errRepo := errors.New("repo: connection refused")
+
+	tests := []struct {
+		name     string
+		findByID func(ctx context.Context, id string) (*User, error)
+		wantUser *User
+		wantErr  error
+	}{
+		{
+			name: "found",
+			findByID: func(_ context.Context, id string) (*User, error) {
+				return &User{ID: id, Name: "Ada"}, nil
+			},
+			wantUser: &User{ID: "1", Name: "Ada"},
+		},
+		{
+			name: "not found",
+			findByID: func(context.Context, string) (*User, error) {
+				return nil, ErrUserNotFound
+			},
+			wantErr: ErrUserNotFound,
+		},
+		{
+			name: "repo error",
+			findByID: func(context.Context, string) (*User, error) {
+				return nil, errRepo
+			},
+			wantErr: errRepo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(&mockRepository{findByIDFunc: tt.findByID})
+
+			got, err := svc.GetUser(context.Background(), "1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetUser() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (got == nil || *got != *tt.wantUser) {
+				t.Fatalf("GetUser() = %v, want %v", got, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestService_GetUserBulk(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+	for _, u := range []User{{ID: "1", Name: "Ada"}, {ID: "2", Name: "Grace"}} {
+		u := u
+		if err := repo.Save(ctx, &u); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	svc := NewService(repo)
+	got, err := svc.GetUserBulk(ctx, []string{"1", "missing", "2"})
+	if err != nil {
+		t.Fatalf("GetUserBulk() error = %v, want nil", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("GetUserBulk() = %v, want 2 users", got)
+	}
+}
+
+func TestService_GetUserBulk_FallsBackToFindByID(t *testing.T) {
+	// mockRepository has no FindByIDs method, so it doesn't satisfy
+	// bulkRepository and GetUserBulk must fall back to one FindByID
+	// call per id.
+	errRepo := errors.New("repo: connection refused")
+	users := map[string]*User{"1": {ID: "1", Name: "Ada"}}
+
+	repo := &mockRepository{
+		findByIDFunc: func(_ context.Context, id string) (*User, error) {
+			switch id {
+			case "1":
+				return users["1"], nil
+			case "missing":
+				return nil, ErrUserNotFound
+			default:
+				return nil, errRepo
+			}
+		},
+	}
+	svc := NewService(repo)
+
+	t.Run("skips not-found ids", func(t *testing.T) {
+		got, err := svc.GetUserBulk(context.Background(), []string{"1", "missing"})
+		if err != nil {
+			t.Fatalf("GetUserBulk() error = %v, want nil", err)
+		}
+		if len(got) != 1 || got[0].ID != "1" {
+			t.Fatalf("GetUserBulk() = %v, want [1]", got)
+		}
+	})
+
+	t.Run("propagates other errors", func(t *testing.T) {
+		_, err := svc.GetUserBulk(context.Background(), []string{"1", "boom"})
+		if !errors.Is(err, errRepo) {
+			t.Fatalf("GetUserBulk() error = %v, want %v", err, errRepo)
+		}
+	})
+}
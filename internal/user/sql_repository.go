@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// SQLRepository is a Repository backed by a database/sql connection. It
+// expects a "users" table with "id" and "name" columns.
+type SQLRepository struct {
+	db *sql.DB
+}
+
+// NewSQLRepository returns a Repository backed by db.
+func NewSQLRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+// FindByID implements Repository.
+func (r *SQLRepository) FindByID(ctx context.Context, id string) (*User, error) {
+	var u User
+	row := r.db.QueryRowContext(ctx, `SELECT id, name FROM users WHERE id = ?`, id)
+	if err := row.Scan(&u.ID, &u.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+// Save implements Repository.
+func (r *SQLRepository) Save(ctx context.Context, u *User) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO users (id, name) VALUES (?, ?)
+		 ON CONFLICT(id) DO UPDATE SET name = excluded.name`,
+		u.ID, u.Name)
+	return err
+}
+
+// Delete implements Repository.
+func (r *SQLRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// List implements Repository.
+func (r *SQLRepository) List(ctx context.Context) ([]*User, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name FROM users`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name); err != nil {
+			return nil, err
+		}
+		out = append(out, &u)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyBounds are the upper bounds of the GetUser latency histogram,
+// in ascending order. A call that exceeds the last bound falls into the
+// implicit "+Inf" bucket.
+var latencyBounds = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+}
+
+// Stats accumulates a histogram of GetUser call latencies.
+type Stats struct {
+	mu     sync.Mutex
+	counts []int64 // len(latencyBounds)+1, index len(latencyBounds) is "+Inf"
+}
+
+func (s *Stats) observe(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.counts == nil {
+		s.counts = make([]int64, len(latencyBounds)+1)
+	}
+	for i, bound := range latencyBounds {
+		if d <= bound {
+			s.counts[i]++
+			return
+		}
+	}
+	s.counts[len(latencyBounds)]++
+}
+
+// LatencySnapshot is a point-in-time copy of the histogram, keyed by
+// bucket upper bound ("1ms", "5ms", ..., "+Inf").
+type LatencySnapshot map[string]int64
+
+// Snapshot returns the current histogram.
+func (s *Stats) Snapshot() LatencySnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := s.counts
+	if counts == nil {
+		counts = make([]int64, len(latencyBounds)+1)
+	}
+
+	out := make(LatencySnapshot, len(latencyBounds)+1)
+	for i, bound := range latencyBounds {
+		out[bound.String()] = counts[i]
+	}
+	out["+Inf"] = counts[len(latencyBounds)]
+	return out
+}
@@ -0,0 +1,14 @@
+// Package user contains the user domain model and the usecase that
+// operates on it, independent of any particular storage backend.
+package user
+
+import "errors"
+
+// ErrUserNotFound is returned when a lookup finds no matching user.
+var ErrUserNotFound = errors.New("user: not found")
+
+// User is the domain entity stored and returned by the repository.
+type User struct {
+	ID   string
+	Name string
+}
@@ -1,3 +1,7 @@
+//go:build ignore
+
+// This file is a parser fixture: intentionally-incomplete sample input
+// for the code-intelligence parser tests, not a buildable package.
 package main
 
 func Greet(name string) string {